@@ -0,0 +1,206 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// domainState is where a single domain sits in the backup run, as driven
+// by logParser watching the script's output.
+type domainState int
+
+const (
+	domainPending domainState = iota
+	domainRunning
+	domainDone
+	domainError
+	domainSkipped
+)
+
+func (s domainState) icon() string {
+	switch s {
+	case domainRunning:
+		return "🔄"
+	case domainDone:
+		return "✅"
+	case domainError:
+		return "❌"
+	case domainSkipped:
+		return "⏭"
+	default:
+		return "⏳"
+	}
+}
+
+func (s domainState) label() string {
+	switch s {
+	case domainRunning:
+		return "running"
+	case domainDone:
+		return "done"
+	case domainError:
+		return "error"
+	case domainSkipped:
+		return "skipped"
+	default:
+		return "pending"
+	}
+}
+
+// domainStatus tracks one domain's progress through the run.
+type domainStatus struct {
+	name      string
+	state     domainState
+	startedAt time.Time
+	endedAt   time.Time
+}
+
+// defaultLogPattern recognizes the "[domain] event" convention used by
+// domain-based-backup.sh; plugins with a different output format can
+// override it by implementing LogPatternPlugin.
+var defaultLogPattern = regexp.MustCompile(`(?i)^\[(?P<domain>[^\]]+)\]\s+(?P<event>start|done|error|skip)\b`)
+
+// logPatternFor returns p's log pattern if it implements LogPatternPlugin,
+// otherwise defaultLogPattern.
+func logPatternFor(p ScriptPlugin) *regexp.Regexp {
+	if lp, ok := p.(LogPatternPlugin); ok {
+		if re := lp.LogPattern(); re != nil {
+			return re
+		}
+	}
+	return defaultLogPattern
+}
+
+// logParser matches subprocess output lines against a regex with named
+// "domain" and "event" groups, driving the stepRunning sidebar's per-domain
+// status. Lines that don't match, or that name a domain outside the run's
+// domain list, are left for the scrolling log pane and otherwise ignored.
+type logParser struct {
+	re      *regexp.Regexp
+	order   []string
+	domains map[string]*domainStatus
+}
+
+func newLogParser(domains []string, re *regexp.Regexp) *logParser {
+	lp := &logParser{
+		re:      re,
+		order:   append([]string(nil), domains...),
+		domains: make(map[string]*domainStatus, len(domains)),
+	}
+	for _, d := range domains {
+		lp.domains[strings.ToLower(d)] = &domainStatus{name: d, state: domainPending}
+	}
+	return lp
+}
+
+// Feed parses line and updates the matching domain's state, reporting
+// whether it recognized a tracked domain.
+func (lp *logParser) Feed(line string) bool {
+	mm := lp.re.FindStringSubmatch(line)
+	if mm == nil {
+		return false
+	}
+	var domain, event string
+	for i, name := range lp.re.SubexpNames() {
+		switch name {
+		case "domain":
+			domain = mm[i]
+		case "event":
+			event = mm[i]
+		}
+	}
+	st, ok := lp.domains[strings.ToLower(domain)]
+	if !ok {
+		return false
+	}
+
+	now := time.Now()
+	switch strings.ToLower(event) {
+	case "start":
+		st.state = domainRunning
+		st.startedAt = now
+	case "done":
+		st.state = domainDone
+		st.endedAt = now
+	case "error":
+		st.state = domainError
+		st.endedAt = now
+	case "skip":
+		st.state = domainSkipped
+		st.endedAt = now
+	default:
+		return false
+	}
+	return true
+}
+
+// Statuses returns every tracked domain's current status, in the order the
+// run was started with.
+func (lp *logParser) Statuses() []domainStatus {
+	out := make([]domainStatus, len(lp.order))
+	for i, d := range lp.order {
+		out[i] = *lp.domains[strings.ToLower(d)]
+	}
+	return out
+}
+
+// domainSummary is one domain's entry in runSummary / summary.json.
+type domainSummary struct {
+	Domain          string  `json:"domain"`
+	State           string  `json:"state"`
+	DurationSeconds float64 `json:"duration_seconds,omitempty"`
+}
+
+// runSummary is the final report written to logs/summary-<timestamp>.json
+// once a run reaches stepDone.
+type runSummary struct {
+	StartedAt  time.Time       `json:"started_at"`
+	EndedAt    time.Time       `json:"ended_at"`
+	DurationMS int64           `json:"duration_ms"`
+	Counts     map[string]int  `json:"counts"`
+	Domains    []domainSummary `json:"domains"`
+}
+
+// Summary builds the final report from the current domain statuses.
+func (lp *logParser) Summary(startedAt, endedAt time.Time) runSummary {
+	s := runSummary{
+		StartedAt:  startedAt,
+		EndedAt:    endedAt,
+		DurationMS: endedAt.Sub(startedAt).Milliseconds(),
+		Counts:     map[string]int{},
+	}
+	for _, st := range lp.Statuses() {
+		ds := domainSummary{Domain: st.name, State: st.state.label()}
+		if !st.startedAt.IsZero() && !st.endedAt.IsZero() {
+			ds.DurationSeconds = st.endedAt.Sub(st.startedAt).Seconds()
+		}
+		s.Domains = append(s.Domains, ds)
+		s.Counts[st.state.label()]++
+	}
+	return s
+}
+
+// writeSummaryFile writes s as JSON to a timestamped file under ./logs/,
+// next to wherever writeLogFile would save that run's log, and returns the
+// path written.
+func writeSummaryFile(s runSummary) (string, error) {
+	dir := "logs"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("create %s: %w", dir, err)
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal summary: %w", err)
+	}
+	name := fmt.Sprintf("summary-%s.json", timestampForFilename())
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("write %s: %w", path, err)
+	}
+	return path, nil
+}
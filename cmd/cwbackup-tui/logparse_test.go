@@ -0,0 +1,47 @@
+package main
+
+import "testing"
+
+func TestLogParserFeed(t *testing.T) {
+	lp := newLogParser([]string{"example.com", "other.net"}, defaultLogPattern)
+
+	if lp.Feed("just some unrelated output") {
+		t.Fatal("Feed on a non-matching line returned true")
+	}
+	if lp.Feed("[unknown.org] start") {
+		t.Fatal("Feed on an untracked domain returned true")
+	}
+
+	if !lp.Feed("[EXAMPLE.COM] start") {
+		t.Fatal("Feed on a start event returned false")
+	}
+	if !lp.Feed("[other.net] skip") {
+		t.Fatal("Feed on a skip event returned false")
+	}
+	if !lp.Feed("[example.com] done") {
+		t.Fatal("Feed on a done event returned false")
+	}
+
+	statuses := lp.Statuses()
+	if len(statuses) != 2 {
+		t.Fatalf("Statuses() returned %d entries, want 2", len(statuses))
+	}
+	if statuses[0].name != "example.com" || statuses[0].state != domainDone {
+		t.Errorf("example.com status = %+v, want domainDone", statuses[0])
+	}
+	if statuses[1].name != "other.net" || statuses[1].state != domainSkipped {
+		t.Errorf("other.net status = %+v, want domainSkipped", statuses[1])
+	}
+}
+
+func TestLogParserFeedErrorEvent(t *testing.T) {
+	lp := newLogParser([]string{"example.com"}, defaultLogPattern)
+
+	if !lp.Feed("[example.com] error") {
+		t.Fatal("Feed on an error event returned false")
+	}
+	statuses := lp.Statuses()
+	if statuses[0].state != domainError {
+		t.Errorf("state = %v, want domainError", statuses[0].state)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"flag"
 	"fmt"
 	"io"
 	"os"
@@ -12,24 +13,38 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"syscall"
 	"time"
 
+	"github.com/atotto/clipboard"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textarea"
 	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
 	"github.com/charmbracelet/lipgloss"
 	tea "github.com/charmbracelet/bubbletea"
+
+	"github.com/sameerakhtari/cw-scripts-tui/internal/config"
 )
 
 const defaultScript = "./domain-based-backup.sh"
 
+// defaultGrace is the time a cancelled/timed-out process is given to exit
+// after SIGTERM before startProcessCmd escalates to SIGKILL.
+const defaultGrace = 10 * time.Second
+
+// heartbeatInterval is how long the viewport can go without new output
+// before Update's tickMsg handler emits a "still running" line.
+const heartbeatInterval = 30 * time.Second
+
 type step int
 
 const (
-	stepEmail step = iota
-	stepAPI
-	stepDomains
+	stepProfilePick step = iota
+	stepProfileName
+	stepPassphrase
+	stepScriptPick
+	stepPrompt
 	stepConfirm
 	stepRunning
 	stepDone
@@ -39,16 +54,134 @@ type lineMsg string
 type tickMsg struct{}
 type doneMsg struct{ err error }
 
+// promptWidget adapts a single PromptSpec to whatever bubbles component
+// fits its PromptKind, so stepPrompt can drive an arbitrary plugin-defined
+// sequence without hard-coding a field per question.
+type promptWidget struct {
+	spec PromptSpec
+	text textinput.Model
+	area textarea.Model
+	flag bool
+}
+
+func newPromptWidget(spec PromptSpec) promptWidget {
+	w := promptWidget{spec: spec}
+	switch spec.Kind {
+	case PromptDomainList:
+		a := textarea.New()
+		a.Placeholder = spec.Placeholder
+		a.ShowLineNumbers = false
+		a.SetHeight(10)
+		a.CharLimit = 0
+		w.area = a
+	case PromptBool:
+		// no text widget; flag is toggled directly
+	default:
+		t := textinput.New()
+		t.Placeholder = spec.Placeholder
+		t.Prompt = spec.Label + ": "
+		if spec.Kind == PromptAPIKey {
+			t.EchoMode = textinput.EchoPassword
+			t.EchoCharacter = '•'
+		}
+		w.text = t
+	}
+	return w
+}
+
+func (w *promptWidget) Focus() tea.Cmd {
+	switch w.spec.Kind {
+	case PromptDomainList:
+		return w.area.Focus()
+	case PromptBool:
+		return nil
+	default:
+		return w.text.Focus()
+	}
+}
+
+func (w *promptWidget) Blur() {
+	switch w.spec.Kind {
+	case PromptDomainList:
+		w.area.Blur()
+	case PromptBool:
+	default:
+		w.text.Blur()
+	}
+}
+
+func (w promptWidget) View() string {
+	switch w.spec.Kind {
+	case PromptDomainList:
+		return w.area.View()
+	case PromptBool:
+		box := "[ ]"
+		if w.flag {
+			box = "[x]"
+		}
+		return fmt.Sprintf("%s %s (space to toggle)", box, w.spec.Label)
+	default:
+		return w.text.View()
+	}
+}
+
+func (w *promptWidget) Update(msg tea.Msg) tea.Cmd {
+	switch w.spec.Kind {
+	case PromptDomainList:
+		var cmd tea.Cmd
+		w.area, cmd = w.area.Update(msg)
+		return cmd
+	case PromptBool:
+		if km, ok := msg.(tea.KeyMsg); ok && km.String() == " " {
+			w.flag = !w.flag
+		}
+		return nil
+	default:
+		var cmd tea.Cmd
+		w.text, cmd = w.text.Update(msg)
+		return cmd
+	}
+}
+
+func (w promptWidget) Value() string {
+	switch w.spec.Kind {
+	case PromptDomainList:
+		return w.area.Value()
+	case PromptBool:
+		if w.flag {
+			return "true"
+		}
+		return "false"
+	default:
+		return w.text.Value()
+	}
+}
+
+func (w promptWidget) empty() bool {
+	return w.spec.Kind != PromptBool && strings.TrimSpace(w.Value()) == ""
+}
+
 type model struct {
-	// config
-	scriptPath string
+	// profiles
+	cfg           *config.Config
+	profileCursor int
+	profileMode   string // "load" or "save"
+	loadProfile   *config.Profile
+	pendingName   string
+	authInput     textinput.Model
+	statusNote    string
+
+	// available scripts
+	plugins      []ScriptPlugin
+	pluginCursor int
 
-	// inputs
-	email   textinput.Model
-	apiKey  textinput.Model
-	domArea textarea.Model
+	// chosen plugin + its dynamic prompt sequence
+	plugin    ScriptPlugin
+	widgets   []promptWidget
+	promptIdx int
+	answers   map[string]string
 
-	// parsed/normalized preview
+	// parsed/normalized preview (for plugins with a PromptDomainList prompt)
 	normalized []string
 
 	// run state
@@ -63,9 +196,28 @@ type model struct {
 	cancel context.CancelFunc
 	cmd    *exec.Cmd
 
+	// deadline/cancel and heartbeat tuning
+	timeout         time.Duration // 0 disables the deadline
+	grace           time.Duration // SIGTERM -> SIGKILL grace period
+	runStartedAt    time.Time
+	lastOutputAt    time.Time
+	lastHeartbeatAt time.Time
+
 	// output cache
 	logBuf bytes.Buffer
 
+	// per-domain progress, parsed from subprocess output during stepRunning
+	logParser   *logParser
+	summaryPath string
+
+	// transient status line (clipboard/log-save feedback)
+	status string
+
+	// script file watcher
+	stopWatch    chan struct{}
+	scriptEvents chan scriptChangedMsg
+	scriptBanner string
+
 	// styles
 	titleStyle lipgloss.Style
 	helpStyle  lipgloss.Style
@@ -73,31 +225,21 @@ type model struct {
 	errStyle   lipgloss.Style
 }
 
+// initialModel builds the model's plugin list. scriptPath, when non-empty,
+// overrides the built-in domain-based-backup plugin's target — this keeps
+// the CW_BACKUP_SCRIPT env var and positional-arg override working for
+// callers who haven't moved to the plugins/ directory yet.
 func initialModel(scriptPath string) model {
-	email := textinput.New()
-	email.Placeholder = "you@example.com"
-	email.Prompt = "Cloudways email: "
-	email.Focus()
-
-	if v := os.Getenv("CW_EMAIL"); v != "" {
-		email.SetValue(v)
-	}
-
-	api := textinput.New()
-	api.Prompt = "Cloudways API key: "
-	api.EchoMode = textinput.EchoPassword
-	api.EchoCharacter = '•'
-	if v := os.Getenv("CW_API_KEY"); v != "" {
-		api.SetValue(v)
+	plugins := Plugins()
+	if scriptPath != "" {
+		plugins[0] = newDomainBackupPlugin(scriptPath)
 	}
 
-	dom := textarea.New()
-	dom.Placeholder = "Paste domain(s) here (any format). Press Ctrl+D when done."
-	dom.ShowLineNumbers = false
-	dom.SetHeight(10)
-	dom.CharLimit = 0
-	if v := os.Getenv("CW_DOMAINS"); v != "" {
-		dom.SetValue(v)
+	cfg, err := config.Load()
+	if err != nil {
+		// a corrupt/unreadable config shouldn't block the TUI from starting;
+		// the user just won't see any saved profiles this run.
+		cfg = &config.Config{}
 	}
 
 	sp := spinner.New()
@@ -106,16 +248,28 @@ func initialModel(scriptPath string) model {
 	vp := viewport.New(100, 18)
 	vp.YPosition = 0
 
+	ai := textinput.New()
+	ai.Prompt = "Passphrase: "
+	ai.EchoMode = textinput.EchoPassword
+	ai.EchoCharacter = '•'
+
+	stage := stepProfilePick
+	if len(cfg.Profiles) == 0 {
+		stage = stepScriptPick
+	}
+
 	return model{
-		scriptPath: scriptPath,
-		email:      email,
-		apiKey:     api,
-		domArea:    dom,
-		stage:      stepEmail,
-		spinner:    sp,
-		viewport:   vp,
-		lines:      make(chan string, 4096),
-		done:       make(chan error, 1),
+		cfg:          cfg,
+		authInput:    ai,
+		plugins:      plugins,
+		stage:        stage,
+		spinner:      sp,
+		viewport:     vp,
+		lines:        make(chan string, 4096),
+		done:         make(chan error, 1),
+		stopWatch:    make(chan struct{}),
+		scriptEvents: make(chan scriptChangedMsg, 1),
+		grace:        defaultGrace,
 
 		titleStyle: lipgloss.NewStyle().Bold(true),
 		helpStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("245")),
@@ -124,22 +278,169 @@ func initialModel(scriptPath string) model {
 	}
 }
 
-func (m model) Init() tea.Cmd { return nil }
+// retargetWatcher stops any previous script watch and starts one on path.
+// The perpetual waitForScriptChange listener chain (kicked off once from
+// Init) keeps reading from the same m.scriptEvents channel regardless of
+// which path is currently being watched.
+func (m *model) retargetWatcher(path string) {
+	m.stopWatcherChan()
+	m.stopWatch = make(chan struct{})
+	startScriptWatch(path, m.scriptEvents, m.stopWatch)
+}
+
+// stopWatcherChan shuts down the active script watcher, if any. Safe to
+// call more than once.
+func (m model) stopWatcherChan() {
+	if m.stopWatch == nil {
+		return
+	}
+	select {
+	case <-m.stopWatch:
+	default:
+		close(m.stopWatch)
+	}
+}
+
+// quitCmd stops the script watcher before returning tea.Quit, so the
+// fsnotify goroutine and its OS watch don't outlive the program.
+func (m model) quitCmd() tea.Cmd {
+	m.stopWatcherChan()
+	return tea.Quit
+}
+
+// selectPlugin builds the dynamic prompt sequence for the chosen plugin,
+// pre-filling the conventional CW_EMAIL/CW_API_KEY/CW_DOMAINS env vars
+// where a prompt's Key matches.
+func (m *model) selectPlugin(p ScriptPlugin) {
+	m.plugin = p
+	m.answers = map[string]string{}
+	prompts := p.Prompts()
+	m.widgets = make([]promptWidget, len(prompts))
+	for i, spec := range prompts {
+		w := newPromptWidget(spec)
+		switch spec.Key {
+		case "email":
+			if v := os.Getenv("CW_EMAIL"); v != "" {
+				w.text.SetValue(v)
+			}
+		case "api_key":
+			if v := os.Getenv("CW_API_KEY"); v != "" {
+				w.text.SetValue(v)
+			}
+		case "domains":
+			if v := os.Getenv("CW_DOMAINS"); v != "" {
+				w.area.SetValue(v)
+			}
+		}
+		m.widgets[i] = w
+	}
+	m.promptIdx = 0
+}
+
+// applyProfile overlays a saved profile's values onto the already-built
+// widget sequence, matching by PromptSpec.Key the same way env vars are
+// applied in selectPlugin.
+func (m *model) applyProfile(p *config.Profile, apiKey string) {
+	for i, w := range m.widgets {
+		switch w.spec.Key {
+		case "email":
+			if p.Email != "" {
+				m.widgets[i].text.SetValue(p.Email)
+			}
+		case "api_key":
+			if apiKey != "" {
+				m.widgets[i].text.SetValue(apiKey)
+			}
+		case "domains":
+			if len(p.Domains) > 0 {
+				m.widgets[i].area.SetValue(strings.Join(p.Domains, "\n"))
+			}
+		}
+	}
+}
+
+// profileFromAnswers builds an unencrypted Profile snapshot of the
+// current plugin answers, for offering a save after a successful run.
+func (m *model) profileFromAnswers(name string) config.Profile {
+	p := config.Profile{Name: name, ScriptPath: m.plugin.ScriptPath()}
+	for _, w := range m.widgets {
+		switch w.spec.Key {
+		case "email":
+			p.Email = w.Value()
+		case "domains":
+			p.Domains = normalizeDomains(w.Value())
+		}
+	}
+	return p
+}
+
+func (m model) Init() tea.Cmd {
+	if len(m.plugins) == 0 {
+		return nil
+	}
+	startScriptWatch(m.plugins[0].ScriptPath(), m.scriptEvents, m.stopWatch)
+	return waitForScriptChange(m.scriptEvents)
+}
 
 func (m model) View() string {
 	switch m.stage {
-	case stepEmail:
-		return m.titleStyle.Render("CW Backup — Bubble Tea TUI") + "\n\n" +
-			m.email.View() + "\n\n" +
-			m.helpStyle.Render("Enter to continue, q to quit.")
-	case stepAPI:
-		return m.titleStyle.Render("CW Backup — Bubble Tea TUI") + "\n\n" +
-			m.apiKey.View() + "\n\n" +
-			m.helpStyle.Render("Enter to continue, q to quit, Esc to go back.")
-	case stepDomains:
-		return m.titleStyle.Render("CW Backup — Paste Domains") + "\n\n" +
-			m.domArea.View() + "\n\n" +
-			m.helpStyle.Render("Ctrl+D when done. q to quit, Esc to go back.")
+	case stepProfilePick:
+		var b strings.Builder
+		b.WriteString(m.titleStyle.Render("CW Backup — Choose a profile") + "\n\n")
+		for i, p := range m.cfg.Profiles {
+			cursor := "  "
+			if i == m.profileCursor {
+				cursor = "> "
+			}
+			fmt.Fprintf(&b, "%s%s (%s)\n", cursor, p.Name, p.Email)
+		}
+		cursor := "  "
+		if m.profileCursor == len(m.cfg.Profiles) {
+			cursor = "> "
+		}
+		fmt.Fprintf(&b, "%s[start without a profile]\n", cursor)
+		b.WriteString("\n" + m.helpStyle.Render("Up/Down to choose, Enter to select, q to quit."))
+		return b.String()
+	case stepProfileName:
+		return m.titleStyle.Render("Save profile") + "\n\n" +
+			m.authInput.View() + "\n\n" +
+			m.helpStyle.Render("Enter a name for this profile. Enter to continue, Esc to skip.")
+	case stepPassphrase:
+		return m.titleStyle.Render("Profile passphrase") + "\n\n" +
+			m.authInput.View() + "\n\n" +
+			m.errStyle.Render(m.statusNote) + "\n" +
+			m.helpStyle.Render("Used to encrypt/decrypt the saved API key. Enter to continue, Esc to cancel.")
+	case stepScriptPick:
+		var b strings.Builder
+		b.WriteString(m.titleStyle.Render("CW Backup — Choose a script") + "\n\n")
+		for i, p := range m.plugins {
+			cursor := "  "
+			if i == m.pluginCursor {
+				cursor = "> "
+			}
+			fmt.Fprintf(&b, "%s%s — %s\n", cursor, p.Name(), p.Description())
+		}
+		b.WriteString("\n" + m.helpStyle.Render("Up/Down to choose, Enter to select, q to quit."))
+		return b.String()
+	case stepPrompt:
+		w := m.widgets[m.promptIdx]
+		next := "Enter"
+		if w.spec.Kind == PromptDomainList {
+			next = "Ctrl+D"
+		}
+		help := fmt.Sprintf("Step %d/%d. %s to continue, q to quit, Esc to go back.", m.promptIdx+1, len(m.widgets), next)
+		if w.spec.Kind == PromptDomainList {
+			help += " Ctrl+V to paste."
+		}
+		if m.status != "" {
+			help = m.status + "\n" + help
+		}
+		if m.scriptBanner != "" {
+			help = m.helpStyle.Render(m.scriptBanner) + "\n" + help
+		}
+		return m.titleStyle.Render("CW Backup — "+m.plugin.Name()) + "\n\n" +
+			w.View() + "\n\n" +
+			m.helpStyle.Render(help)
 	case stepConfirm:
 		var list string
 		if len(m.normalized) == 0 {
@@ -147,82 +448,300 @@ func (m model) View() string {
 		} else {
 			list = "  • " + strings.Join(m.normalized, "\n  • ")
 		}
-		return m.titleStyle.Render("Confirm") + "\n\n" +
-			fmt.Sprintf("Email: %s\nDomains (%d):\n%s\n\n", m.email.Value(), len(m.normalized), list) +
-			m.helpStyle.Render("[y] run  [n] edit domains  [b] back")
+		var b strings.Builder
+		b.WriteString(m.titleStyle.Render("Confirm") + "\n\n")
+		if m.scriptBanner != "" {
+			b.WriteString(m.helpStyle.Render(m.scriptBanner) + "\n\n")
+		}
+		for _, spec := range m.plugin.Prompts() {
+			if spec.Kind == PromptDomainList {
+				fmt.Fprintf(&b, "%s (%d):\n%s\n", spec.Label, len(m.normalized), list)
+				continue
+			}
+			val := m.answers[spec.Key]
+			if spec.Kind == PromptAPIKey && val != "" {
+				val = strings.Repeat("•", len(val))
+			}
+			fmt.Fprintf(&b, "%s: %s\n", spec.Label, val)
+		}
+		b.WriteString("\n" + m.helpStyle.Render("[y] run  [n] edit answers  [b] back"))
+		return b.String()
 	case stepRunning:
 		header := m.titleStyle.Render("Running backup… ") + m.spinner.View()
-		return header + "\n\n" + m.viewport.View() + "\n\n" + m.helpStyle.Render("q/ctrl+c to cancel. PgUp/PgDn to scroll.")
+		help := "q/ctrl+c to cancel. PgUp/PgDn to scroll. Ctrl+Y copy log. Ctrl+S save log."
+		if m.status != "" {
+			help = m.status + "\n" + help
+		}
+		body := m.viewport.View()
+		if m.logParser != nil {
+			body = lipgloss.JoinHorizontal(lipgloss.Top, m.renderSidebar(), "  ", body)
+		}
+		return header + "\n\n" + body + "\n\n" + m.helpStyle.Render(help)
 	case stepDone:
 		header := m.titleStyle.Render("Finished")
-		return header + "\n\n" + m.viewport.View() + "\n\n" + m.helpStyle.Render("Press q to exit.")
+		help := "Press q to exit. Ctrl+Y copy log. Ctrl+S save log."
+		if m.plugin != nil {
+			help = "[s] save answers as a profile. " + help
+		}
+		if m.statusNote != "" {
+			help = m.okStyle.Render(m.statusNote) + "\n" + help
+		}
+		if m.status != "" {
+			help = m.status + "\n" + help
+		}
+		var summary string
+		if m.logParser != nil {
+			summary = m.renderSummaryTable() + "\n\n"
+		}
+		return header + "\n\n" + summary + m.viewport.View() + "\n\n" + m.helpStyle.Render(help)
 	default:
 		return ""
 	}
 }
 
 func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case statusMsg:
+		m.status = string(msg)
+		return m, nil
+	case clearStatusMsg:
+		m.status = ""
+		return m, nil
+	case scriptChangedMsg:
+		switch m.stage {
+		case stepPrompt, stepConfirm:
+			m.scriptBanner = fmt.Sprintf("script updated at %s, re-validating…", msg.at.Format("15:04:05"))
+			if err := assertExecutable(msg.path); err != nil {
+				m.scriptBanner = fmt.Sprintf("script updated at %s, but now invalid: %s", msg.at.Format("15:04:05"), err.Error())
+			}
+		case stepRunning:
+			m.appendLogLine(m.errStyle.Render(fmt.Sprintf("warning: script changed on disk at %s (this run keeps using what it already started with)", msg.at.Format("15:04:05"))))
+		}
+		return m, waitForScriptChange(m.scriptEvents)
+	}
+
 	switch m.stage {
-	case stepEmail:
+	case stepProfilePick:
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
 			switch msg.String() {
 			case "q", "ctrl+c":
-				return m, tea.Quit
+				return m, m.quitCmd()
+			case "up", "k":
+				if m.profileCursor > 0 {
+					m.profileCursor--
+				}
+				return m, nil
+			case "down", "j":
+				if m.profileCursor < len(m.cfg.Profiles) {
+					m.profileCursor++
+				}
+				return m, nil
 			case "enter":
-				if strings.TrimSpace(m.email.Value()) == "" {
+				if m.profileCursor == len(m.cfg.Profiles) {
+					m.profileMode = ""
+					m.stage = stepScriptPick
 					return m, nil
 				}
-				m.stage = stepAPI
-				m.apiKey.Focus()
+				m.loadProfile = &m.cfg.Profiles[m.profileCursor]
+				m.profileMode = "load"
+				m.statusNote = ""
+				m.authInput.SetValue("")
+				m.authInput.Prompt = "Passphrase: "
+				m.authInput.EchoMode = textinput.EchoPassword
+				m.authInput.Focus()
+				m.stage = stepPassphrase
+				return m, nil
+			}
+		}
+		return m, nil
+
+	case stepProfileName:
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch msg.String() {
+			case "q", "ctrl+c":
+				return m, m.quitCmd()
+			case "esc":
+				m.stage = stepDone
+				return m, nil
+			case "enter":
+				name := strings.TrimSpace(m.authInput.Value())
+				if name == "" {
+					return m, nil
+				}
+				m.pendingName = name
+				m.profileMode = "save"
+				m.statusNote = ""
+				m.authInput.SetValue("")
+				m.authInput.Prompt = "Passphrase: "
+				m.authInput.EchoMode = textinput.EchoPassword
+				m.authInput.Focus()
+				m.stage = stepPassphrase
 				return m, nil
 			}
 		}
 		var cmd tea.Cmd
-		m.email, cmd = m.email.Update(msg)
+		m.authInput, cmd = m.authInput.Update(msg)
 		return m, cmd
 
-	case stepAPI:
+	case stepPassphrase:
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
 			switch msg.String() {
 			case "q", "ctrl+c":
-				return m, tea.Quit
+				return m, m.quitCmd()
 			case "esc":
-				m.stage = stepEmail
-				m.email.Focus()
+				if m.profileMode == "save" {
+					m.stage = stepDone
+				} else {
+					m.stage = stepProfilePick
+				}
 				return m, nil
 			case "enter":
-				if strings.TrimSpace(m.apiKey.Value()) == "" {
+				passphrase := m.authInput.Value()
+				if m.profileMode == "load" {
+					apiKey, err := m.loadProfile.DecryptAPIKey(passphrase)
+					if err != nil {
+						m.statusNote = err.Error()
+						return m, nil
+					}
+					plugin := m.plugins[0]
+					for _, p := range m.plugins {
+						if p.ScriptPath() == m.loadProfile.ScriptPath {
+							plugin = p
+							break
+						}
+					}
+					m.selectPlugin(plugin)
+					m.applyProfile(m.loadProfile, apiKey)
+					m.retargetWatcher(plugin.ScriptPath())
+					m.widgets[0].Focus()
+					m.stage = stepPrompt
+					return m, nil
+				}
+				// profileMode == "save"
+				p := m.profileFromAnswers(m.pendingName)
+				apiKey := m.answers["api_key"]
+				if err := p.EncryptAPIKey(apiKey, passphrase); err != nil {
+					m.statusNote = err.Error()
 					return m, nil
 				}
-				m.stage = stepDomains
-				m.domArea.Focus()
+				m.cfg.Upsert(p)
+				if err := m.cfg.Save(); err != nil {
+					m.statusNote = "save failed: " + err.Error()
+				} else {
+					m.statusNote = "saved profile " + p.Name
+				}
+				m.stage = stepDone
 				return m, nil
 			}
 		}
 		var cmd tea.Cmd
-		m.apiKey, cmd = m.apiKey.Update(msg)
+		m.authInput, cmd = m.authInput.Update(msg)
 		return m, cmd
 
-	case stepDomains:
+	case stepScriptPick:
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			switch msg.String() {
+			case "q", "ctrl+c":
+				return m, m.quitCmd()
+			case "up", "k":
+				if m.pluginCursor > 0 {
+					m.pluginCursor--
+				}
+				return m, nil
+			case "down", "j":
+				if m.pluginCursor < len(m.plugins)-1 {
+					m.pluginCursor++
+				}
+				return m, nil
+			case "enter":
+				if len(m.plugins) == 0 {
+					return m, nil
+				}
+				m.selectPlugin(m.plugins[m.pluginCursor])
+				m.retargetWatcher(m.plugins[m.pluginCursor].ScriptPath())
+				m.widgets[0].Focus()
+				m.stage = stepPrompt
+				return m, nil
+			}
+		}
+		return m, nil
+
+	case stepPrompt:
+		w := &m.widgets[m.promptIdx]
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
 			switch msg.String() {
 			case "q", "ctrl+c":
-				return m, tea.Quit
+				return m, m.quitCmd()
 			case "esc":
-				m.stage = stepAPI
-				m.apiKey.Focus()
+				w.Blur()
+				if m.promptIdx == 0 {
+					if m.profileMode == "load" {
+						m.stage = stepProfilePick
+					} else {
+						m.stage = stepScriptPick
+					}
+					return m, nil
+				}
+				m.promptIdx--
+				m.widgets[m.promptIdx].Focus()
 				return m, nil
-			case "ctrl+d":
-				m.normalized = normalizeDomains(m.domArea.Value())
-				m.stage = stepConfirm
+			case "enter":
+				if w.spec.Kind == PromptDomainList {
+					break
+				}
+				if w.spec.Required && w.empty() {
+					return m, nil
+				}
+				m.answers[w.spec.Key] = w.Value()
+				w.Blur()
+				if m.promptIdx == len(m.widgets)-1 {
+					if specs := m.plugin.Prompts(); len(specs) > 0 {
+						for i, spec := range specs {
+							if spec.Kind == PromptDomainList {
+								m.normalized = normalizeDomains(m.widgets[i].Value())
+							}
+						}
+					}
+					m.stage = stepConfirm
+					return m, nil
+				}
+				m.promptIdx++
+				m.widgets[m.promptIdx].Focus()
 				return m, nil
+			case "ctrl+d":
+				if w.spec.Kind == PromptDomainList {
+					m.answers[w.spec.Key] = w.Value()
+					w.Blur()
+					m.normalized = normalizeDomains(w.Value())
+					if m.promptIdx == len(m.widgets)-1 {
+						m.stage = stepConfirm
+						return m, nil
+					}
+					m.promptIdx++
+					m.widgets[m.promptIdx].Focus()
+					return m, nil
+				}
+			case "ctrl+v":
+				if w.spec.Kind == PromptDomainList {
+					text, err := clipboard.ReadAll()
+					if err != nil {
+						return m, showStatus("clipboard read failed: " + err.Error())
+					}
+					cur := w.area.Value()
+					if cur != "" && !strings.HasSuffix(cur, "\n") {
+						cur += "\n"
+					}
+					w.area.SetValue(cur + text)
+					return m, showStatus(fmt.Sprintf("pasted %s from clipboard", formatBytes(len(text))))
+				}
 			}
 		}
-		var cmd tea.Cmd
-		m.domArea, cmd = m.domArea.Update(msg)
+		cmd := w.Update(msg)
 		return m, cmd
 
 	case stepConfirm:
@@ -230,24 +749,37 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case tea.KeyMsg:
 			switch msg.String() {
 			case "q", "ctrl+c":
-				return m, tea.Quit
+				return m, m.quitCmd()
 			case "b":
-				m.stage = stepAPI
-				m.apiKey.Focus()
+				m.promptIdx = len(m.widgets) - 1
+				m.widgets[m.promptIdx].Focus()
+				m.stage = stepPrompt
 				return m, nil
 			case "n":
-				m.stage = stepDomains
-				m.domArea.Focus()
+				m.promptIdx = 0
+				m.widgets[m.promptIdx].Focus()
+				m.stage = stepPrompt
 				return m, nil
 			case "y":
-				if err := assertExecutable(m.scriptPath); err != nil {
+				if err := assertExecutable(m.plugin.ScriptPath()); err != nil {
 					m.viewport.SetContent(m.errStyle.Render(err.Error()))
 					m.stage = stepDone
 					return m, nil
 				}
 				m.stage = stepRunning
 				m.viewport.SetContent("")
-				m.ctx, m.cancel = context.WithCancel(context.Background())
+				if m.timeout > 0 {
+					m.ctx, m.cancel = context.WithDeadline(context.Background(), time.Now().Add(m.timeout))
+				} else {
+					m.ctx, m.cancel = context.WithCancel(context.Background())
+				}
+				now := time.Now()
+				m.runStartedAt, m.lastOutputAt, m.lastHeartbeatAt = now, now, now
+				m.logParser = nil
+				m.summaryPath = ""
+				if len(m.normalized) > 0 {
+					m.logParser = newLogParser(m.normalized, logPatternFor(m.plugin))
+				}
 				return m, tea.Batch(m.startProcessCmd(), nextTick())
 			}
 		}
@@ -261,34 +793,65 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				if m.cancel != nil {
 					m.cancel()
 				}
-				return m, tea.Quit
+				return m, m.quitCmd()
 			case "pgdown", " ", "j", "down":
 				m.viewport.LineDown(3)
 				return m, nil
 			case "pgup", "k", "up":
 				m.viewport.LineUp(3)
 				return m, nil
+			case "ctrl+y":
+				return m, copyLogToClipboard(m.logBuf.Bytes())
+			case "ctrl+s":
+				return m, saveLogToFile(m.logBuf.Bytes())
 			}
 		case tickMsg:
 			// drain lines
+			sawOutput := false
 			for i := 0; i < 200; i++ {
 				select {
 				case ln := <-m.lines:
+					if m.logParser != nil {
+						m.logParser.Feed(ln)
+					}
 					m.appendLogLine(ln)
+					sawOutput = true
 				default:
 					i = 200
 				}
 			}
+			if sawOutput {
+				m.lastOutputAt = time.Now()
+			}
 			select {
 			case err := <-m.done:
-				if err != nil && !errors.Is(err, context.Canceled) {
+				switch {
+				case errors.Is(m.ctx.Err(), context.DeadlineExceeded):
+					m.appendLogLine(m.helpStyle.Render(fmt.Sprintf("Timed out after %s, process cancelled.", m.timeout)))
+				case m.ctx.Err() != nil:
+					m.appendLogLine(m.helpStyle.Render("Cancelled."))
+				case err != nil:
 					m.appendLogLine(m.errStyle.Render("Process error: " + err.Error()))
-				} else {
+				default:
 					m.appendLogLine(m.okStyle.Render("Done."))
 				}
+				if m.logParser != nil {
+					summary := m.logParser.Summary(m.runStartedAt, time.Now())
+					if path, werr := writeSummaryFile(summary); werr == nil {
+						m.summaryPath = path
+					}
+				}
 				m.stage = stepDone
 				return m, nil
 			default:
+				if time.Since(m.lastOutputAt) >= heartbeatInterval && time.Since(m.lastHeartbeatAt) >= heartbeatInterval {
+					m.lastHeartbeatAt = time.Now()
+					m.appendLogLine(m.helpStyle.Render(fmt.Sprintf(
+						"…still running, elapsed %s, last output %s ago",
+						time.Since(m.runStartedAt).Round(time.Second),
+						time.Since(m.lastOutputAt).Round(time.Second),
+					)))
+				}
 				return m, nextTick()
 			}
 		default:
@@ -301,8 +864,24 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case stepDone:
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
-			if msg.String() == "q" || msg.String() == "ctrl+c" {
-				return m, tea.Quit
+			switch msg.String() {
+			case "q", "ctrl+c":
+				return m, m.quitCmd()
+			case "s":
+				if m.plugin == nil {
+					return m, nil
+				}
+				m.statusNote = ""
+				m.authInput.SetValue("")
+				m.authInput.Prompt = "Profile name: "
+				m.authInput.EchoMode = textinput.EchoNormal
+				m.authInput.Focus()
+				m.stage = stepProfileName
+				return m, nil
+			case "ctrl+y":
+				return m, copyLogToClipboard(m.logBuf.Bytes())
+			case "ctrl+s":
+				return m, saveLogToFile(m.logBuf.Bytes())
 			}
 		}
 		return m, nil
@@ -310,6 +889,54 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+const sidebarWidth = 26
+
+// renderSidebar draws the per-domain progress panel shown alongside the
+// log viewport in stepRunning, when the current plugin's domain list is
+// being tracked by a logParser.
+func (m model) renderSidebar() string {
+	var b strings.Builder
+	b.WriteString(m.titleStyle.Render("Domains") + "\n")
+	for _, st := range m.logParser.Statuses() {
+		line := fmt.Sprintf("%s %s", st.state.icon(), st.name)
+		switch st.state {
+		case domainRunning:
+			elapsed := time.Since(st.startedAt).Round(time.Second)
+			line = m.okStyle.Render(line) + " " + m.helpStyle.Render(elapsed.String())
+		case domainDone:
+			line = m.okStyle.Render(line)
+		case domainError:
+			line = m.errStyle.Render(line)
+		case domainSkipped:
+			line = m.helpStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	return lipgloss.NewStyle().Width(sidebarWidth).Render(b.String())
+}
+
+// renderSummaryTable renders the final per-domain outcome table shown on
+// stepDone once a run with a logParser finishes, alongside the path
+// writeSummaryFile wrote summary.json to (if it succeeded).
+func (m model) renderSummaryTable() string {
+	var b strings.Builder
+	b.WriteString(m.titleStyle.Render("Summary") + "\n")
+	for _, st := range m.logParser.Statuses() {
+		line := fmt.Sprintf("%s %-30s %s", st.state.icon(), st.name, st.state.label())
+		switch st.state {
+		case domainDone:
+			line = m.okStyle.Render(line)
+		case domainError:
+			line = m.errStyle.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	if m.summaryPath != "" {
+		b.WriteString(m.helpStyle.Render("wrote " + m.summaryPath))
+	}
+	return b.String()
+}
+
 func (m *model) appendLogLine(s string) {
 	if s == "" {
 		return
@@ -327,7 +954,13 @@ func nextTick() tea.Cmd {
 }
 
 func (m *model) startProcessCmd() tea.Cmd {
-	cmd := exec.CommandContext(m.ctx, "/bin/bash", m.scriptPath)
+	// Plain exec.Command, not CommandContext: ctx cancellation is handled
+	// below with our own SIGTERM-then-grace-then-SIGKILL escalation rather
+	// than CommandContext's immediate SIGKILL. Setpgid puts the whole bash
+	// pipeline in its own process group so a signal reaches every child,
+	// not just /bin/bash.
+	cmd := exec.Command("/bin/bash", m.plugin.ScriptPath())
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
 
 	stdin, _ := cmd.StdinPipe()
 	pipeR, pipeW := io.Pipe()
@@ -345,23 +978,23 @@ func (m *model) startProcessCmd() tea.Cmd {
 		_ = pipeR.Close()
 	}()
 
-	rawDomains := m.domArea.Value()
+	feeder := m.plugin.StdinFeeder(m.answers)
+	ctx := m.ctx
+	grace := m.grace
 
 	return func() tea.Msg {
 		if err := cmd.Start(); err != nil {
 			return doneMsg{err: err}
 		}
 
-		// The bash script expects: email, api key, domains... then EOF
-		_, _ = io.WriteString(stdin, strings.TrimSpace(m.email.Value())+"\n")
-		_, _ = io.WriteString(stdin, strings.TrimSpace(m.apiKey.Value())+"\n")
-		if !strings.HasSuffix(rawDomains, "\n") {
-			rawDomains += "\n"
-		}
-		_, _ = io.WriteString(stdin, rawDomains)
+		exited := make(chan struct{})
+		go escalateOnCancel(ctx, cmd, grace, exited)
+
+		_, _ = io.Copy(stdin, feeder)
 		_ = stdin.Close()
 
 		err := cmd.Wait()
+		close(exited)
 		_ = pipeW.Close()
 		m.cmd = cmd
 		m.cancel = nil
@@ -369,6 +1002,30 @@ func (m *model) startProcessCmd() tea.Cmd {
 	}
 }
 
+// escalateOnCancel waits for ctx to be cancelled (user quit/cancel, or a
+// --timeout deadline), sends SIGTERM to cmd's process group, and escalates
+// to SIGKILL if the process hasn't exited within grace. It returns as soon
+// as exited is closed, whichever happens first.
+func escalateOnCancel(ctx context.Context, cmd *exec.Cmd, grace time.Duration, exited chan struct{}) {
+	select {
+	case <-ctx.Done():
+	case <-exited:
+		return
+	}
+
+	pgid, err := syscall.Getpgid(cmd.Process.Pid)
+	if err != nil {
+		pgid = cmd.Process.Pid
+	}
+	_ = syscall.Kill(-pgid, syscall.SIGTERM)
+
+	select {
+	case <-exited:
+	case <-time.After(grace):
+		_ = syscall.Kill(-pgid, syscall.SIGKILL)
+	}
+}
+
 func normalizeDomains(s string) []string {
 	s = strings.ToLower(s)
 	repls := []string{"\t", " ", ",", " ", ";", " ", "|", " ", "\r", " ", "https://", "", "http://", "", "/", " "}
@@ -408,11 +1065,15 @@ func assertExecutable(p string) error {
 }
 
 func main() {
+	timeout := flag.Duration("timeout", 0, "deadline for the running script; 0 disables it")
+	grace := flag.Duration("grace", defaultGrace, "time between SIGTERM and SIGKILL when cancelling or hitting --timeout")
+	flag.Parse()
+
 	// script path via env, arg, or default
 	script := os.Getenv("CW_BACKUP_SCRIPT")
 	if script == "" {
-		if len(os.Args) > 1 {
-			script = os.Args[1]
+		if flag.NArg() > 0 {
+			script = flag.Arg(0)
 		} else {
 			script = defaultScript
 		}
@@ -423,7 +1084,11 @@ func main() {
 		}
 	}
 
-	p := tea.NewProgram(initialModel(script), tea.WithAltScreen())
+	m := initialModel(script)
+	m.timeout = *timeout
+	m.grace = *grace
+
+	p := tea.NewProgram(m, tea.WithAltScreen())
 	if _, err := p.Run(); err != nil {
 		fmt.Println("error:", err)
 		os.Exit(1)
@@ -0,0 +1,161 @@
+package main
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// PromptKind identifies how a PromptSpec should be rendered and collected
+// in the TUI's dynamic prompt sequence.
+type PromptKind int
+
+const (
+	PromptEmail PromptKind = iota
+	PromptAPIKey
+	PromptDomainList
+	PromptFreeText
+	PromptBool
+)
+
+// PromptSpec describes a single question a ScriptPlugin wants answered
+// before its script runs. Key is used to look the answer back up in the
+// map handed to StdinFeeder.
+type PromptSpec struct {
+	Key         string
+	Kind        PromptKind
+	Label       string
+	Placeholder string
+	Required    bool
+}
+
+// ScriptPlugin is implemented by anything that can be run from the
+// stepScriptPick stage: it declares what it needs from the user and how
+// to turn those answers into the stdin stream its script expects.
+type ScriptPlugin interface {
+	Name() string
+	Description() string
+	ScriptPath() string
+	Prompts() []PromptSpec
+	StdinFeeder(answers map[string]string) io.Reader
+}
+
+// LogPatternPlugin is implemented by a ScriptPlugin whose script reports
+// per-domain progress in a format other than logParser's default
+// "[domain] start|done|error|skip" convention. The stepRunning sidebar
+// falls back to defaultLogPattern for plugins that don't implement this.
+type LogPatternPlugin interface {
+	LogPattern() *regexp.Regexp
+}
+
+var registry []ScriptPlugin
+
+// RegisterPlugin adds a plugin to the built-in registry. Called from
+// init() by plugins that ship with the binary.
+func RegisterPlugin(p ScriptPlugin) {
+	registry = append(registry, p)
+}
+
+// Plugins returns every registered plugin, built-ins first in
+// registration order followed by anything discovered under a plugins/
+// directory in the working directory.
+func Plugins() []ScriptPlugin {
+	out := make([]ScriptPlugin, len(registry))
+	copy(out, registry)
+	out = append(out, discoverDirPlugins("plugins")...)
+	return out
+}
+
+func init() {
+	RegisterPlugin(newDomainBackupPlugin(defaultScript))
+}
+
+// domainBackupPlugin wraps the original hard-coded domain-based-backup.sh
+// flow (email, API key, domain list) as the first ScriptPlugin so existing
+// behavior keeps working unchanged.
+type domainBackupPlugin struct {
+	scriptPath string
+}
+
+func newDomainBackupPlugin(scriptPath string) *domainBackupPlugin {
+	return &domainBackupPlugin{scriptPath: scriptPath}
+}
+
+func (p *domainBackupPlugin) Name() string        { return "domain-based-backup" }
+func (p *domainBackupPlugin) Description() string { return "Back up one or more Cloudways domains" }
+func (p *domainBackupPlugin) ScriptPath() string  { return p.scriptPath }
+
+func (p *domainBackupPlugin) Prompts() []PromptSpec {
+	return []PromptSpec{
+		{Key: "email", Kind: PromptEmail, Label: "Cloudways email", Placeholder: "you@example.com", Required: true},
+		{Key: "api_key", Kind: PromptAPIKey, Label: "Cloudways API key", Required: true},
+		{Key: "domains", Kind: PromptDomainList, Label: "Domains", Placeholder: "Paste domain(s) here (any format). Press Ctrl+D when done.", Required: true},
+	}
+}
+
+func (p *domainBackupPlugin) StdinFeeder(answers map[string]string) io.Reader {
+	var b strings.Builder
+	b.WriteString(strings.TrimSpace(answers["email"]) + "\n")
+	b.WriteString(strings.TrimSpace(answers["api_key"]) + "\n")
+	domains := answers["domains"]
+	if !strings.HasSuffix(domains, "\n") {
+		domains += "\n"
+	}
+	b.WriteString(domains)
+	return strings.NewReader(b.String())
+}
+
+// genericScriptPlugin wraps a .sh file discovered under plugins/ that has
+// no dedicated Go implementation. It falls back to a single free-text
+// prompt whose value is piped to the script as-is; scripts that need a
+// richer prompt sequence should register a purpose-built ScriptPlugin
+// instead.
+type genericScriptPlugin struct {
+	name       string
+	scriptPath string
+}
+
+func (p *genericScriptPlugin) Name() string        { return p.name }
+func (p *genericScriptPlugin) Description() string { return "Discovered script: " + p.scriptPath }
+func (p *genericScriptPlugin) ScriptPath() string  { return p.scriptPath }
+
+func (p *genericScriptPlugin) Prompts() []PromptSpec {
+	return []PromptSpec{
+		{Key: "input", Kind: PromptFreeText, Label: "Input", Placeholder: "Stdin for " + p.name, Required: false},
+	}
+}
+
+func (p *genericScriptPlugin) StdinFeeder(answers map[string]string) io.Reader {
+	v := answers["input"]
+	if !strings.HasSuffix(v, "\n") {
+		v += "\n"
+	}
+	return strings.NewReader(v)
+}
+
+// discoverDirPlugins scans dir (relative to the working directory) for
+// *.sh files, executable or not, and wraps each as a genericScriptPlugin. Missing
+// or unreadable directories are silently treated as "no extra plugins" —
+// the plugins/ directory is optional.
+func discoverDirPlugins(dir string) []ScriptPlugin {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var out []ScriptPlugin
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".sh") {
+			continue
+		}
+		name := strings.TrimSuffix(e.Name(), ".sh")
+		out = append(out, &genericScriptPlugin{
+			name:       name,
+			scriptPath: filepath.Join(dir, e.Name()),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name() < out[j].Name() })
+	return out
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// statusMsg carries a brief, transient message (e.g. "copied 12.0 KiB to
+// clipboard") to be rendered in helpStyle for a couple of seconds.
+type statusMsg string
+
+// clearStatusMsg fires after the display window for a statusMsg elapses.
+type clearStatusMsg struct{}
+
+const statusDuration = 2 * time.Second
+
+// showStatus sets the status line immediately and schedules it to clear
+// itself after statusDuration.
+func showStatus(s string) tea.Cmd {
+	return tea.Batch(func() tea.Msg { return statusMsg(s) }, clearStatusTick())
+}
+
+// formatBytes renders n as a short human-readable size, e.g. "512 B" or
+// "12.0 KiB".
+func formatBytes(n int) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := int64(n) / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
+
+// writeLogFile writes data to a timestamped file under ./logs/, creating
+// the directory if needed, and returns the path written.
+func writeLogFile(data []byte) (string, error) {
+	dir := "logs"
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("create %s: %w", dir, err)
+	}
+	name := fmt.Sprintf("%s.log", timestampForFilename())
+	path := filepath.Join(dir, name)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		return "", fmt.Errorf("write %s: %w", path, err)
+	}
+	return path, nil
+}
+
+func timestampForFilename() string {
+	return time.Now().Format("20060102-150405")
+}
+
+func clearStatusTick() tea.Cmd {
+	return tea.Tick(statusDuration, func(time.Time) tea.Msg { return clearStatusMsg{} })
+}
+
+// copyLogToClipboard copies data to the system clipboard and reports the
+// result via the transient status line.
+func copyLogToClipboard(data []byte) tea.Cmd {
+	return tea.Batch(func() tea.Msg {
+		if err := clipboard.WriteAll(string(data)); err != nil {
+			return statusMsg("clipboard copy failed: " + err.Error())
+		}
+		return statusMsg(fmt.Sprintf("copied %s to clipboard", formatBytes(len(data))))
+	}, clearStatusTick())
+}
+
+// saveLogToFile writes data under ./logs/ and reports the result via the
+// transient status line.
+func saveLogToFile(data []byte) tea.Cmd {
+	return tea.Batch(func() tea.Msg {
+		path, err := writeLogFile(data)
+		if err != nil {
+			return statusMsg(err.Error())
+		}
+		return statusMsg("wrote log to " + path)
+	}, clearStatusTick())
+}
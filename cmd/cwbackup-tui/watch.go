@@ -0,0 +1,78 @@
+package main
+
+import (
+	"path/filepath"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/fsnotify/fsnotify"
+)
+
+// scriptChangedMsg reports that the watched script file was modified, after
+// debouncing bursts of editor/filesystem events into one notification.
+type scriptChangedMsg struct {
+	path string
+	at   time.Time
+}
+
+const watchDebounce = 250 * time.Millisecond
+
+// startScriptWatch watches path's containing directory (editors frequently
+// replace a file via rename-into-place rather than writing it directly,
+// which a direct file watch would miss) and sends a debounced
+// scriptChangedMsg on events whenever path itself changes. The watch
+// stops, and its fsnotify.Watcher is closed, when stop is closed. Set up
+// failures (e.g. the directory disappeared) are swallowed: a script that
+// can't be watched just never produces change notifications.
+func startScriptWatch(path string, events chan<- scriptChangedMsg, stop <-chan struct{}) {
+	w, err := fsnotify.NewWatcher()
+	if err != nil {
+		return
+	}
+	if err := w.Add(filepath.Dir(path)); err != nil {
+		_ = w.Close()
+		return
+	}
+
+	go func() {
+		defer w.Close()
+		var fire <-chan time.Time
+		for {
+			select {
+			case ev, ok := <-w.Events:
+				if !ok {
+					return
+				}
+				if filepath.Clean(ev.Name) != filepath.Clean(path) {
+					continue
+				}
+				fire = time.After(watchDebounce)
+			case <-fire:
+				select {
+				case events <- scriptChangedMsg{path: path, at: time.Now()}:
+				default:
+				}
+				fire = nil
+			case _, ok := <-w.Errors:
+				if !ok {
+					return
+				}
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// waitForScriptChange turns the next value off events into a tea.Msg;
+// Update re-issues this as a tea.Cmd after handling each scriptChangedMsg
+// so the watch keeps delivering for the life of the program.
+func waitForScriptChange(events <-chan scriptChangedMsg) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-events
+		if !ok {
+			return nil
+		}
+		return ev
+	}
+}
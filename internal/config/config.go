@@ -0,0 +1,180 @@
+// Package config loads and saves named run profiles for cwbackup-tui from
+// ~/.config/cw-scripts-tui/config.yaml, so repeat runs don't require
+// re-typing credentials or exporting CW_EMAIL/CW_API_KEY/CW_DOMAINS.
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	dirName  = "cw-scripts-tui"
+	fileName = "config.yaml"
+
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+	saltLen      = 16
+)
+
+// Profile is one saved run configuration. The API key is stored only in
+// encrypted form (APIKeyCiphertext/APIKeySalt/APIKeyNonce); it is never
+// written in the clear.
+type Profile struct {
+	Name             string   `yaml:"name"`
+	Email            string   `yaml:"email"`
+	APIKeyCiphertext []byte   `yaml:"api_key_ciphertext,omitempty"`
+	APIKeySalt       []byte   `yaml:"api_key_salt,omitempty"`
+	APIKeyNonce      []byte   `yaml:"api_key_nonce,omitempty"`
+	Domains          []string `yaml:"domains,omitempty"`
+	ScriptPath       string   `yaml:"script_path,omitempty"`
+}
+
+// Config is the on-disk shape of config.yaml: a set of named profiles.
+type Config struct {
+	Profiles []Profile `yaml:"profiles"`
+}
+
+// Path returns the default config.yaml location, honoring XDG_CONFIG_HOME.
+func Path() (string, error) {
+	base := os.Getenv("XDG_CONFIG_HOME")
+	if base == "" {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", fmt.Errorf("resolve home directory: %w", err)
+		}
+		base = filepath.Join(home, ".config")
+	}
+	return filepath.Join(base, dirName, fileName), nil
+}
+
+// Load reads the config file, returning an empty Config if it doesn't
+// exist yet.
+func Load() (*Config, error) {
+	path, err := Path()
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return &Config{}, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+	var cfg Config
+	if err := yaml.Unmarshal(data, &cfg); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &cfg, nil
+}
+
+// Save writes the config file, creating its parent directory (mode 0700)
+// if needed.
+func (c *Config) Save() error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return fmt.Errorf("create config dir: %w", err)
+	}
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("marshal config: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("write %s: %w", path, err)
+	}
+	return nil
+}
+
+// Find returns the profile with the given name, if any.
+func (c *Config) Find(name string) (*Profile, bool) {
+	for i := range c.Profiles {
+		if c.Profiles[i].Name == name {
+			return &c.Profiles[i], true
+		}
+	}
+	return nil, false
+}
+
+// Upsert adds p or replaces the existing profile with the same name.
+func (c *Config) Upsert(p Profile) {
+	for i := range c.Profiles {
+		if c.Profiles[i].Name == p.Name {
+			c.Profiles[i] = p
+			return
+		}
+	}
+	c.Profiles = append(c.Profiles, p)
+}
+
+// EncryptAPIKey derives a key from passphrase and seals apiKey into the
+// profile's ciphertext/salt/nonce fields.
+func (p *Profile) EncryptAPIKey(apiKey, passphrase string) error {
+	salt := make([]byte, saltLen)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return fmt.Errorf("generate salt: %w", err)
+	}
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return fmt.Errorf("derive key: %w", err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+	p.APIKeyCiphertext = gcm.Seal(nil, nonce, []byte(apiKey), nil)
+	p.APIKeySalt = salt
+	p.APIKeyNonce = nonce
+	return nil
+}
+
+// DecryptAPIKey reverses EncryptAPIKey given the same passphrase. A wrong
+// passphrase surfaces as an authentication error from GCM, not silent
+// garbage.
+func (p *Profile) DecryptAPIKey(passphrase string) (string, error) {
+	if len(p.APIKeyCiphertext) == 0 {
+		return "", nil
+	}
+	key, err := scrypt.Key([]byte(passphrase), p.APIKeySalt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return "", fmt.Errorf("derive key: %w", err)
+	}
+	gcm, err := newGCM(key)
+	if err != nil {
+		return "", err
+	}
+	plain, err := gcm.Open(nil, p.APIKeyNonce, p.APIKeyCiphertext, nil)
+	if err != nil {
+		return "", fmt.Errorf("decrypt api key (wrong passphrase?): %w", err)
+	}
+	return string(plain), nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("init cipher: %w", err)
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("init gcm: %w", err)
+	}
+	return gcm, nil
+}
@@ -0,0 +1,45 @@
+package config
+
+import "testing"
+
+func TestEncryptDecryptAPIKeyRoundTrip(t *testing.T) {
+	p := &Profile{Name: "prod"}
+	const apiKey = "super-secret-key"
+
+	if err := p.EncryptAPIKey(apiKey, "correct horse battery staple"); err != nil {
+		t.Fatalf("EncryptAPIKey: %v", err)
+	}
+	if len(p.APIKeyCiphertext) == 0 || len(p.APIKeySalt) == 0 || len(p.APIKeyNonce) == 0 {
+		t.Fatalf("expected ciphertext/salt/nonce to be populated, got %+v", p)
+	}
+
+	got, err := p.DecryptAPIKey("correct horse battery staple")
+	if err != nil {
+		t.Fatalf("DecryptAPIKey: %v", err)
+	}
+	if got != apiKey {
+		t.Fatalf("DecryptAPIKey = %q, want %q", got, apiKey)
+	}
+}
+
+func TestDecryptAPIKeyWrongPassphrase(t *testing.T) {
+	p := &Profile{Name: "prod"}
+	if err := p.EncryptAPIKey("super-secret-key", "right passphrase"); err != nil {
+		t.Fatalf("EncryptAPIKey: %v", err)
+	}
+
+	if _, err := p.DecryptAPIKey("wrong passphrase"); err == nil {
+		t.Fatal("DecryptAPIKey with wrong passphrase: expected error, got nil")
+	}
+}
+
+func TestDecryptAPIKeyEmptyProfile(t *testing.T) {
+	p := &Profile{Name: "no-key"}
+	got, err := p.DecryptAPIKey("whatever")
+	if err != nil {
+		t.Fatalf("DecryptAPIKey: %v", err)
+	}
+	if got != "" {
+		t.Fatalf("DecryptAPIKey on empty profile = %q, want empty", got)
+	}
+}